@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// procedureTmplData is Procedure plus the derived fields the template needs.
+type procedureTmplData struct {
+	Procedure
+	MethodName string
+}
+
+// tmplData is the top-level value passed to sourceTmpl.
+type tmplData struct {
+	Package    string
+	Procedures []procedureTmplData
+}
+
+// methodName derives a Go method/identifier name from a dot-separated
+// procedure name, e.g. "users.Create" becomes "UsersCreate". Segments are
+// title-cased and concatenated, rather than just taking the last segment,
+// so that procedures in different namespaces (e.g. "users.Create" and
+// "billing.Create") don't collide on the generated ServerInterface.
+func methodName(procedure string) string {
+	var b strings.Builder
+	for _, segment := range strings.Split(procedure, ".") {
+		if segment == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(segment[:1]))
+		b.WriteString(segment[1:])
+	}
+	return b.String()
+}
+
+// Generate renders the ServerInterface, RegisterServer and Client for
+// schema as Go source. The result is not gofmt'd; callers should pass it
+// through go/format.Source.
+func Generate(schema *Schema) ([]byte, error) {
+	data := tmplData{Package: schema.Package}
+	for _, proc := range schema.Procedures {
+		data.Procedures = append(data.Procedures, procedureTmplData{
+			Procedure:  proc,
+			MethodName: methodName(proc.Name),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("executing template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+var sourceTmpl = template.Must(template.New("source").Parse(`// Code generated by lambda-router-gen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	router "github.com/edstell/lambda-router"
+)
+
+// ServerInterface is implemented by the service handling the procedures
+// described in the schema this file was generated from.
+type ServerInterface interface {
+{{- range .Procedures}}
+	{{.MethodName}}(context.Context, {{.Request}}) ({{.Response}}, error)
+{{- end}}
+}
+
+// RegisterServer registers every procedure of impl on r via router.RouteFunc.
+func RegisterServer(r *router.Router, impl ServerInterface) {
+{{- range .Procedures}}
+	r.RouteFunc({{printf "%q" .Name}}, impl.{{.MethodName}})
+{{- end}}
+}
+
+// Invoker abstracts invoking a Lambda function by ARN or name, as
+// implemented by *lambda.Client from
+// github.com/aws/aws-sdk-go-v2/service/lambda.
+type Invoker interface {
+	Invoke(context.Context, *lambda.InvokeInput, ...func(*lambda.Options)) (*lambda.InvokeOutput, error)
+}
+
+// Client invokes the procedures described in the schema this file was
+// generated from on a remote Lambda function, wrapping the request and
+// response in the same envelope router.Router expects and returns.
+type Client struct {
+	Invoker      Invoker
+	FunctionName string
+}
+{{range .Procedures}}
+// {{.MethodName}} invokes the {{printf "%q" .Name}} procedure.
+func (c *Client) {{.MethodName}}(ctx context.Context, req {{.Request}}) ({{.Response}}, error) {
+	var resp {{.Response}}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return resp, fmt.Errorf("marshaling {{.Name}} request: %w", err)
+	}
+	payload, err := json.Marshal(router.Request{Procedure: {{printf "%q" .Name}}, Body: body})
+	if err != nil {
+		return resp, fmt.Errorf("marshaling {{.Name}} envelope: %w", err)
+	}
+
+	out, err := c.Invoker.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName: aws.String(c.FunctionName),
+		Payload:      payload,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("invoking {{.Name}}: %w", err)
+	}
+	if out.FunctionError != nil {
+		return resp, fmt.Errorf("invoking {{.Name}}: %s: %s", aws.ToString(out.FunctionError), out.Payload)
+	}
+
+	var rsp router.Response
+	if err := json.Unmarshal(out.Payload, &rsp); err != nil {
+		return resp, fmt.Errorf("unmarshaling {{.Name}} response: %w", err)
+	}
+	if len(rsp.Error) > 0 {
+		var rerr router.RouterError
+		if err := json.Unmarshal(rsp.Error, &rerr); err == nil && rerr.Code != "" {
+			return resp, &rerr
+		}
+		return resp, fmt.Errorf("{{.Name}}: %s", rsp.Error)
+	}
+	if len(rsp.Body) > 0 {
+		if err := json.Unmarshal(rsp.Body, &resp); err != nil {
+			return resp, fmt.Errorf("unmarshaling {{.Name}} response body: %w", err)
+		}
+	}
+	return resp, nil
+}
+{{end}}`))
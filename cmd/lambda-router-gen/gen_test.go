@@ -0,0 +1,37 @@
+package main
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMethodName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "UsersCreate", methodName("users.Create"))
+	assert.Equal(t, "Charge", methodName("Charge"))
+	assert.Equal(t, "BillingCharge", methodName("billing.Charge"))
+}
+
+func TestLoadSchema(t *testing.T) {
+	t.Parallel()
+	schema, err := LoadSchema("testdata/example.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "myservice", schema.Package)
+	require.Len(t, schema.Procedures, 3)
+	assert.Equal(t, Procedure{Name: "users.Create", Request: "CreateUserRequest", Response: "CreateUserResponse"}, schema.Procedures[0])
+}
+
+func TestGenerateProducesValidGoSource(t *testing.T) {
+	t.Parallel()
+	schema, err := LoadSchema("testdata/example.yaml")
+	require.NoError(t, err)
+
+	src, err := Generate(schema)
+	require.NoError(t, err)
+
+	_, err = format.Source(src)
+	require.NoError(t, err)
+}
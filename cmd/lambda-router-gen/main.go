@@ -0,0 +1,55 @@
+// Command lambda-router-gen generates a ServerInterface and a typed Client
+// for the procedures listed in a schema file, so that producers and
+// consumers of a lambda-router Lambda don't have to hand-write the
+// procedure envelope on either side.
+//
+// Usage:
+//
+//	lambda-router-gen -schema schema.yaml -out zz_generated.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+)
+
+func main() {
+	var schemaPath, outPath string
+	flag.StringVar(&schemaPath, "schema", "", "path to the lambda-router-gen schema YAML file")
+	flag.StringVar(&outPath, "out", "", "path to write the generated Go source to")
+	flag.Parse()
+
+	if schemaPath == "" || outPath == "" {
+		fmt.Fprintln(os.Stderr, "usage: lambda-router-gen -schema schema.yaml -out zz_generated.go")
+		os.Exit(2)
+	}
+
+	if err := run(schemaPath, outPath); err != nil {
+		fmt.Fprintln(os.Stderr, "lambda-router-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaPath, outPath string) error {
+	schema, err := LoadSchema(schemaPath)
+	if err != nil {
+		return err
+	}
+
+	src, err := Generate(schema)
+	if err != nil {
+		return fmt.Errorf("generating source: %w", err)
+	}
+
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("formatting generated source: %w", err)
+	}
+
+	if err := os.WriteFile(outPath, formatted, 0o644); err != nil {
+		return fmt.Errorf("writing %q: %w", outPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Procedure describes a single procedure exposed by a Router: its name, and
+// the Go request/response types used to call it. Request and Response name
+// types which must already exist in the generated file's package.
+type Procedure struct {
+	Name     string `yaml:"procedure"`
+	Request  string `yaml:"request"`
+	Response string `yaml:"response"`
+}
+
+// Schema is the top-level shape of a lambda-router-gen schema file.
+type Schema struct {
+	Package    string      `yaml:"package"`
+	Procedures []Procedure `yaml:"procedures"`
+}
+
+// LoadSchema reads and parses the schema file at path.
+func LoadSchema(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading schema %q: %w", path, err)
+	}
+	var schema Schema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("parsing schema %q: %w", path, err)
+	}
+	if schema.Package == "" {
+		return nil, fmt.Errorf("schema %q: package is required", path)
+	}
+	for _, proc := range schema.Procedures {
+		if proc.Name == "" || proc.Request == "" || proc.Response == "" {
+			return nil, fmt.Errorf("schema %q: procedure, request and response are all required, got %+v", path, proc)
+		}
+	}
+	return &schema, nil
+}
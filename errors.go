@@ -0,0 +1,44 @@
+package router
+
+import "encoding/json"
+
+// RouterError is an error carrying a machine-readable Code alongside a
+// human-readable Message and optional structured Details, so that callers
+// can programmatically distinguish and handle different failure modes
+// instead of pattern-matching a bare error string.
+//
+// With the Router's default error marshaling, a Handler which returns a
+// *RouterError (or wraps one) will have it marshaled to Response.Error as a
+// JSON object of the form {"code":..., "message":..., "details":...}, rather
+// than the bare message string used for other errors. A function passed to
+// MarshalErrorsWith replaces this default.
+type RouterError struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// NewError constructs a *RouterError with the given code and message.
+func NewError(code, message string) *RouterError {
+	return &RouterError{Code: code, Message: message}
+}
+
+// WithDetails sets Details on e and returns it, for chaining off NewError.
+func (e *RouterError) WithDetails(details json.RawMessage) *RouterError {
+	e.Details = details
+	return e
+}
+
+// Error implements the error interface, returning the Message.
+func (e *RouterError) Error() string {
+	return e.Message
+}
+
+// ErrCodeUnrecognizedProcedure is the Code carried by ErrUnrecognizedProcedure.
+const ErrCodeUnrecognizedProcedure = "UNRECOGNIZED_PROCEDURE"
+
+// ErrUnrecognizedProcedure is the sentinel wrapped by the error Router.Handle
+// returns when a Request names a procedure with no registered Handler.
+// Callers can compare against it with errors.Is to distinguish a routing
+// failure from an error returned by a Handler.
+var ErrUnrecognizedProcedure = NewError(ErrCodeUnrecognizedProcedure, "unrecognized procedure")
@@ -0,0 +1,37 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleWithRouterError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.Route("Do", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return nil, NewError("NOT_FOUND", "widget not found").WithDetails(json.RawMessage(`{"id":"123"}`))
+	}))
+
+	result, err := router.Handle(ctx, Request{Procedure: "Do"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"code":"NOT_FOUND","message":"widget not found","details":{"id":"123"}}`, string(result.Error))
+}
+
+func TestHandleWithRouterErrorWrapped(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.Route("Do", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return nil, fmt.Errorf("loading widget: %w", NewError("NOT_FOUND", "widget not found"))
+	}))
+
+	result, err := router.Handle(ctx, Request{Procedure: "Do"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"code":"NOT_FOUND","message":"widget not found"}`, string(result.Error))
+}
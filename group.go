@@ -0,0 +1,38 @@
+package router
+
+// groupSeparator joins a Group's prefix to the procedure names registered
+// on it.
+const groupSeparator = "."
+
+// Group returns a child Router whose procedures are registered on the
+// parent under "prefix.name" (or, if the parent is itself a Group,
+// "parent-prefix.prefix.name"). This lets a single Lambda host several
+// logical services, e.g. users.Create and billing.Charge, behind one
+// dispatch entry point without the flat Routes map becoming an
+// unstructured global namespace.
+//
+// The child inherits the parent's middlewares registered via Use up to this
+// point; middlewares registered with Use on the child only wrap the
+// child's own routes, not the parent's or any sibling group's.
+func (r *Router) Group(prefix string) *Router {
+	middleware := make([]Middleware, len(r.middleware))
+	copy(middleware, r.middleware)
+
+	return &Router{
+		Routes:          r.Routes,
+		onewayRoutes:    r.onewayRoutes,
+		streamRoutes:    r.streamRoutes,
+		marshalError:    r.marshalError,
+		onewayErrorSink: r.onewayErrorSink,
+		middleware:      middleware,
+		prefix:          r.qualify(prefix),
+	}
+}
+
+// qualify prepends the Router's prefix, if any, to procedure.
+func (r *Router) qualify(procedure string) string {
+	if r.prefix == "" {
+		return procedure
+	}
+	return r.prefix + groupSeparator + procedure
+}
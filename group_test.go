@@ -0,0 +1,78 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupNamespacesProcedures(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	users := router.Group("users")
+	users.Route("Create", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return []byte(`{"created":true}`), nil
+	}))
+
+	result, err := router.Handle(ctx, Request{Procedure: "users.Create"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"created":true}`, string(result.Body))
+}
+
+func TestGroupMiddlewareOnlyAppliesToGroup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	var calls []string
+	router := New()
+	users := router.Group("users")
+	users.Use(recordingMiddleware("users", &calls))
+	users.Route("Create", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return nil, nil
+	}))
+	router.Route("Ping", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return nil, nil
+	}))
+
+	_, err := router.Handle(ctx, Request{Procedure: "Ping"})
+	require.NoError(t, err)
+	assert.Empty(t, calls)
+
+	_, err = router.Handle(ctx, Request{Procedure: "users.Create"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"users:in", "users:out"}, calls)
+}
+
+func TestGroupInheritsParentMiddleware(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	var calls []string
+	router := New()
+	router.Use(recordingMiddleware("global", &calls))
+	users := router.Group("users")
+	users.Route("Create", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return nil, nil
+	}))
+
+	_, err := router.Handle(ctx, Request{Procedure: "users.Create"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"global:in", "global:out"}, calls)
+}
+
+func TestNestedGroupNamespacing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	v1 := router.Group("v1")
+	users := v1.Group("users")
+	users.Route("Create", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return []byte(`{}`), nil
+	}))
+
+	result, err := router.Handle(ctx, Request{Procedure: "v1.users.Create"})
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+}
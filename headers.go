@@ -0,0 +1,46 @@
+package router
+
+import (
+	"context"
+	"sync"
+)
+
+type contextKey int
+
+const (
+	requestHeadersKey contextKey = iota
+	responseHeadersKey
+)
+
+// responseHeaderCollector accumulates headers set via SetResponseHeader
+// during a single Router.Handle call, guarded by a mutex since Handler
+// implementations may set them from other goroutines.
+type responseHeaderCollector struct {
+	mu      sync.Mutex
+	headers map[string]string
+}
+
+func (c *responseHeaderCollector) set(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.headers == nil {
+		c.headers = map[string]string{}
+	}
+	c.headers[key] = value
+}
+
+// HeadersFromContext returns the Headers of the Request currently being
+// handled, or nil if ctx was not derived from one passed to Router.Handle.
+func HeadersFromContext(ctx context.Context) map[string]string {
+	headers, _ := ctx.Value(requestHeadersKey).(map[string]string)
+	return headers
+}
+
+// SetResponseHeader records a header to be emitted on the Headers of the
+// Response returned by Router.Handle. It's a no-op if ctx was not derived
+// from one passed to Router.Handle.
+func SetResponseHeader(ctx context.Context, key, value string) {
+	if collector, ok := ctx.Value(responseHeadersKey).(*responseHeaderCollector); ok {
+		collector.set(key, value)
+	}
+}
@@ -0,0 +1,50 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadersFromContext(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	var got map[string]string
+	router.Route("Do", HandlerFunc(func(ctx context.Context, _ json.RawMessage) (json.RawMessage, error) {
+		got = HeadersFromContext(ctx)
+		return nil, nil
+	}))
+
+	_, err := router.Handle(ctx, Request{
+		Procedure: "Do",
+		Headers:   map[string]string{"X-Correlation-Id": "abc"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Correlation-Id": "abc"}, got)
+}
+
+func TestSetResponseHeader(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.Route("Do", HandlerFunc(func(ctx context.Context, _ json.RawMessage) (json.RawMessage, error) {
+		SetResponseHeader(ctx, "X-Trace-Id", "xyz")
+		return nil, nil
+	}))
+
+	result, err := router.Handle(ctx, Request{Procedure: "Do"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Trace-Id": "xyz"}, result.Headers)
+}
+
+func TestSetResponseHeaderNoopOutsideHandle(t *testing.T) {
+	t.Parallel()
+	assert.NotPanics(t, func() {
+		SetResponseHeader(context.Background(), "X-Trace-Id", "xyz")
+	})
+	assert.Nil(t, HeadersFromContext(context.Background()))
+}
@@ -0,0 +1,31 @@
+package router
+
+// Middleware wraps a Handler with additional behaviour, such as logging,
+// panic recovery, auth, tracing, or metrics. Middlewares are composed around
+// a Handler in registration order, so the first Middleware passed to Use or
+// Route is the outermost, running first on the way in and last on the way
+// out.
+type Middleware func(Handler) Handler
+
+// Use registers middlewares which wrap every procedure subsequently
+// registered with Route, RouteFunc, or RouteOneway. Middlewares registered
+// with Use only apply to routes registered after the call, so Use should be
+// called before any routes it's meant to apply to.
+//
+// Use does not affect RouteStream: StreamHandlers return an io.ReadCloser
+// rather than a Handler's json.RawMessage, so a Middleware can't wrap one.
+// Procedures registered with RouteStream run outside the middleware chain
+// entirely. When HandleStream falls back to a Handler registered with Route
+// or RouteFunc, that Handler runs with the chain built at registration.
+func (r *Router) Use(mws ...Middleware) {
+	r.middleware = append(r.middleware, mws...)
+}
+
+// chain composes the Router's global middlewares and the passed per-route
+// middlewares around handler, in registration order.
+func chain(mws []Middleware, handler Handler) Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		handler = mws[i](handler)
+	}
+	return handler
+}
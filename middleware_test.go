@@ -0,0 +1,50 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recordingMiddleware(name string, calls *[]string) Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, body json.RawMessage) (json.RawMessage, error) {
+			*calls = append(*calls, name+":in")
+			rsp, err := next.Handle(ctx, body)
+			*calls = append(*calls, name+":out")
+			return rsp, err
+		})
+	}
+}
+
+func TestUseWrapsRoutesInRegistrationOrder(t *testing.T) {
+	t.Parallel()
+	var calls []string
+	router := New()
+	router.Use(recordingMiddleware("global", &calls))
+	router.Route("Do", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		calls = append(calls, "handler")
+		return nil, nil
+	}), recordingMiddleware("route", &calls))
+
+	_, err := router.Handle(context.Background(), Request{Procedure: "Do"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"global:in", "route:in", "handler", "route:out", "global:out"}, calls)
+}
+
+func TestUseOnlyAppliesToSubsequentRoutes(t *testing.T) {
+	t.Parallel()
+	var calls []string
+	router := New()
+	router.Route("Before", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return nil, nil
+	}))
+	router.Use(recordingMiddleware("global", &calls))
+
+	_, err := router.Handle(context.Background(), Request{Procedure: "Before"})
+	require.NoError(t, err)
+	assert.Empty(t, calls)
+}
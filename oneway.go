@@ -0,0 +1,53 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// OnewayHandler implementations handle procedures which are invoked purely
+// for their side effects. Unlike Handler, they return no response body: the
+// caller is not kept waiting on them.
+type OnewayHandler interface {
+	Handle(context.Context, json.RawMessage) error
+}
+
+// The OnewayHandlerFunc type is an adapter to allow the use of ordinary
+// functions as OnewayHandlers. If f is a function with the appropriate
+// signature, OnewayHandlerFunc(f) is an OnewayHandler that calls f.
+type OnewayHandlerFunc func(context.Context, json.RawMessage) error
+
+// Handle calls f(ctx, body).
+func (f OnewayHandlerFunc) Handle(ctx context.Context, body json.RawMessage) error {
+	return f(ctx, body)
+}
+
+// RouteOneway registers handler to procedure as a fire-and-forget route. When
+// procedure is invoked, Router.Handle dispatches handler asynchronously and
+// immediately returns an empty Response, without waiting for it to complete.
+// Any error handler returns is passed to the Router's oneway error sink,
+// configured with WithOnewayErrorSink, rather than being propagated to the
+// caller.
+//
+// As with Route, mws are composed with the Router's global middlewares
+// around handler; since Middleware wraps a Handler, handler's response body
+// is always nil.
+func (r *Router) RouteOneway(procedure string, handler OnewayHandler, mws ...Middleware) {
+	adapted := HandlerFunc(func(ctx context.Context, body json.RawMessage) (json.RawMessage, error) {
+		return nil, handler.Handle(ctx, body)
+	})
+	all := make([]Middleware, 0, len(r.middleware)+len(mws))
+	all = append(all, r.middleware...)
+	all = append(all, mws...)
+	r.onewayRoutes[r.qualify(procedure)] = chain(all, adapted)
+}
+
+// WithOnewayErrorSink configures the Router to pass errors returned by
+// oneway handlers to sink, instead of silently discarding them. sink is
+// called from the goroutine dispatching the oneway handler, not the
+// goroutine which called Router.Handle.
+func WithOnewayErrorSink(sink func(context.Context, error)) Option {
+	return func(router *Router) {
+		router.onewayErrorSink = sink
+	}
+}
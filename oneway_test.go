@@ -0,0 +1,55 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleOnewayReturnsImmediately(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	called := make(chan struct{})
+	router := New()
+	router.RouteOneway("Notify", OnewayHandlerFunc(func(context.Context, json.RawMessage) error {
+		close(called)
+		return nil
+	}))
+
+	result, err := router.Handle(ctx, Request{Procedure: "Notify"})
+	require.NoError(t, err)
+	assert.Equal(t, &Response{}, result)
+
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("oneway handler was not invoked")
+	}
+}
+
+func TestHandleOnewaySendsErrorsToSink(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	sunk := make(chan error, 1)
+	router := New(WithOnewayErrorSink(func(_ context.Context, err error) {
+		sunk <- err
+	}))
+	router.RouteOneway("Notify", OnewayHandlerFunc(func(context.Context, json.RawMessage) error {
+		return assert.AnError
+	}))
+
+	result, err := router.Handle(ctx, Request{Procedure: "Notify"})
+	require.NoError(t, err)
+	assert.Equal(t, &Response{}, result)
+
+	select {
+	case err := <-sunk:
+		assert.Equal(t, assert.AnError, err)
+	case <-time.After(time.Second):
+		t.Fatal("oneway error was not sunk")
+	}
+}
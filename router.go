@@ -11,8 +11,9 @@ import (
 // contains a Procedure field which names the Handler which should handle the
 // request, and the body to be passed to the handler.
 type Request struct {
-	Procedure string          `json:"procedure"`
-	Body      json.RawMessage `json:"body"`
+	Procedure string            `json:"procedure"`
+	Body      json.RawMessage   `json:"body"`
+	Headers   map[string]string `json:"headers,omitempty"`
 }
 
 // Response is returned from the Router.Handle function and is the form of the
@@ -20,8 +21,9 @@ type Request struct {
 // NOTE: Errors returned from a Handler won't be propagated, instead they're
 // marshaled to json and streamed as part of the response.
 type Response struct {
-	Body  json.RawMessage `json:"body,omitempty"`
-	Error json.RawMessage `json:"error,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+	Error   json.RawMessage   `json:"error,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
 }
 
 // Handler implementations will be called by the Router to handle requests made
@@ -44,8 +46,13 @@ func (f HandlerFunc) Handle(ctx context.Context, body json.RawMessage) (json.Raw
 // It does the work of unwrapping the request event and passing it to the
 // relevant Handler, and wrapping the response, or error returned.
 type Router struct {
-	Routes       map[string]Handler
-	marshalError func(error) (json.RawMessage, error)
+	Routes          map[string]Handler
+	onewayRoutes    map[string]Handler
+	streamRoutes    map[string]StreamHandler
+	marshalError    func(error) (json.RawMessage, error)
+	onewayErrorSink func(context.Context, error)
+	middleware      []Middleware
+	prefix          string
 }
 
 // Option implementations can mutate the Router to configure how events should
@@ -55,10 +62,17 @@ type Option func(*Router)
 // New initializes a Router instance with the options passed.
 func New(opts ...Option) *Router {
 	router := &Router{
-		Routes: map[string]Handler{},
+		Routes:       map[string]Handler{},
+		onewayRoutes: map[string]Handler{},
+		streamRoutes: map[string]StreamHandler{},
 		marshalError: func(err error) (json.RawMessage, error) {
+			var rerr *RouterError
+			if errors.As(err, &rerr) {
+				return json.Marshal(rerr)
+			}
 			return []byte(err.Error()), nil
 		},
+		onewayErrorSink: func(context.Context, error) {},
 	}
 	for _, opt := range opts {
 		opt(router)
@@ -66,28 +80,48 @@ func New(opts ...Option) *Router {
 	return router
 }
 
-// Route registers the passed Handler to the procedure name.
+// Route registers the passed Handler to the procedure name, wrapping it with
+// any middlewares registered on the Router via Use, followed by the mws
+// passed here. The resulting chain is built once, at registration time.
 // NOTE: If multiple Handlers are registered to the same procedure, only the
 // last registered will be called.
-func (r *Router) Route(procedure string, handler Handler) {
-	r.Routes[procedure] = handler
+func (r *Router) Route(procedure string, handler Handler, mws ...Middleware) {
+	all := make([]Middleware, 0, len(r.middleware)+len(mws))
+	all = append(all, r.middleware...)
+	all = append(all, mws...)
+	r.Routes[r.qualify(procedure)] = chain(all, handler)
 }
 
 // Handle should be passed to 'lambda.Start' to handle inbound requests.
 func (r *Router) Handle(ctx context.Context, req Request) (*Response, error) {
+	ctx = context.WithValue(ctx, requestHeadersKey, req.Headers)
+
+	if handler, ok := r.onewayRoutes[req.Procedure]; ok {
+		go func() {
+			if _, err := handler.Handle(ctx, req.Body); err != nil {
+				r.onewayErrorSink(ctx, err)
+			}
+		}()
+		return &Response{}, nil
+	}
+
 	handler, ok := r.Routes[req.Procedure]
 	if !ok {
-		return nil, errors.New(fmt.Sprintf("unrecognized procedure '%s'", req.Procedure))
+		return nil, fmt.Errorf("%w: '%s'", ErrUnrecognizedProcedure, req.Procedure)
 	}
+
+	collector := &responseHeaderCollector{}
+	ctx = context.WithValue(ctx, responseHeadersKey, collector)
+
 	rsp, err := handler.Handle(ctx, req.Body)
 	if err != nil {
 		body, me := r.marshalError(err)
 		if me != nil {
 			body = []byte(err.Error())
 		}
-		return &Response{Error: body}, nil
+		return &Response{Error: body, Headers: collector.headers}, nil
 	}
-	return &Response{Body: rsp}, nil
+	return &Response{Body: rsp, Headers: collector.headers}, nil
 }
 
 // MarshalErrorsWith configures the Router to use the passed function to marshal
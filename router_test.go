@@ -3,6 +3,7 @@ package router
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,7 +27,8 @@ func TestHandleUnrecogizedProcedure(t *testing.T) {
 	router := New()
 	_, err := router.Handle(ctx, Request{})
 	require.Error(t, err)
-	assert.Equal(t, "unrecognized procedure ''", err.Error())
+	assert.Equal(t, "unrecognized procedure: ''", err.Error())
+	assert.True(t, errors.Is(err, ErrUnrecognizedProcedure))
 }
 
 func TestHandleWithResponseBody(t *testing.T) {
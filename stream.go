@@ -0,0 +1,69 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// StreamHandler implementations handle procedures whose response is a
+// stream of bytes rather than a single JSON value, for use with Lambda
+// response streaming. Unlike Handler, the returned io.ReadCloser is not
+// buffered into a Response by the Router; it's passed back to the caller
+// to be copied to the streaming runtime as it's produced.
+type StreamHandler interface {
+	Handle(context.Context, json.RawMessage) (io.ReadCloser, error)
+}
+
+// The StreamHandlerFunc type is an adapter to allow the use of ordinary
+// functions as StreamHandlers. If f is a function with the appropriate
+// signature, StreamHandlerFunc(f) is a StreamHandler that calls f.
+type StreamHandlerFunc func(context.Context, json.RawMessage) (io.ReadCloser, error)
+
+// Handle calls f(ctx, body).
+func (f StreamHandlerFunc) Handle(ctx context.Context, body json.RawMessage) (io.ReadCloser, error) {
+	return f(ctx, body)
+}
+
+// RouteStream registers handler to procedure for use with HandleStream.
+// Unlike Route, RouteFunc, and RouteOneway, handler is not passed through
+// the Router's middleware chain: StreamHandlers return an io.ReadCloser
+// rather than a Handler's json.RawMessage, so a Middleware registered with
+// Use cannot wrap one. Callers needing logging, auth, or recovery around a
+// streamed procedure must apply it inside handler itself.
+// NOTE: If multiple StreamHandlers are registered to the same procedure,
+// only the last registered will be called.
+func (r *Router) RouteStream(procedure string, handler StreamHandler) {
+	r.streamRoutes[r.qualify(procedure)] = handler
+}
+
+// HandleStream should be passed to Lambda's response streaming entry point
+// (in place of Handle) to handle inbound requests whose response should be
+// streamed rather than buffered. If procedure was registered with
+// RouteStream, its StreamHandler is called directly and its io.ReadCloser
+// returned unbuffered. Otherwise, HandleStream falls back to any Handler
+// registered with Route or RouteFunc, buffering its response into an
+// in-memory reader.
+//
+// Unlike Handle, errors are returned directly rather than marshaled onto a
+// Response, matching Lambda's response streaming contract where an error
+// raised before any bytes are written becomes an invoke error.
+func (r *Router) HandleStream(ctx context.Context, req Request) (io.ReadCloser, error) {
+	ctx = context.WithValue(ctx, requestHeadersKey, req.Headers)
+
+	if handler, ok := r.streamRoutes[req.Procedure]; ok {
+		return handler.Handle(ctx, req.Body)
+	}
+
+	handler, ok := r.Routes[req.Procedure]
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnrecognizedProcedure, req.Procedure)
+	}
+	rsp, err := handler.Handle(ctx, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(rsp)), nil
+}
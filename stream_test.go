@@ -0,0 +1,82 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleStreamWithStreamHandler(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.RouteStream("Download", StreamHandlerFunc(func(context.Context, json.RawMessage) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("file contents")), nil
+	}))
+
+	result, err := router.HandleStream(ctx, Request{Procedure: "Download"})
+	require.NoError(t, err)
+	body, err := io.ReadAll(result)
+	require.NoError(t, err)
+	assert.Equal(t, "file contents", string(body))
+}
+
+func TestHandleStreamFallsBackToHandler(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.Route("Do", HandlerFunc(func(context.Context, json.RawMessage) (json.RawMessage, error) {
+		return []byte(`{"ok":true}`), nil
+	}))
+
+	result, err := router.HandleStream(ctx, Request{Procedure: "Do"})
+	require.NoError(t, err)
+	body, err := io.ReadAll(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ok":true}`, string(body))
+}
+
+func TestHandleStreamExposesHeadersFromContext(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	var got map[string]string
+	router.RouteStream("Download", StreamHandlerFunc(func(ctx context.Context, _ json.RawMessage) (io.ReadCloser, error) {
+		got = HeadersFromContext(ctx)
+		return io.NopCloser(strings.NewReader("")), nil
+	}))
+
+	_, err := router.HandleStream(ctx, Request{
+		Procedure: "Download",
+		Headers:   map[string]string{"X-Correlation-Id": "abc"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Correlation-Id": "abc"}, got)
+}
+
+func TestHandleStreamUnrecognizedProcedure(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	_, err := router.HandleStream(ctx, Request{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnrecognizedProcedure)
+}
+
+func TestRouteFuncWithReaderResponse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.RouteFunc("Download", func(context.Context) (io.Reader, error) {
+		return strings.NewReader(`{"streamed":true}`), nil
+	})
+
+	result, err := router.Handle(ctx, Request{Procedure: "Download"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"streamed":true}`, string(result.Body))
+}
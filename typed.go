@@ -0,0 +1,106 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+var (
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+// RouteFunc registers fn to the procedure name, adapting it into a Handler
+// using reflection. fn must be a function of one of the following shapes:
+//
+//	func(context.Context, TReq) (TResp, error)
+//	func(context.Context, TReq) error
+//	func(context.Context) (TResp, error)
+//	func(context.Context) error
+//	func(TReq) (TResp, error)
+//	func(TReq) error
+//
+// where TReq and TResp are any JSON-marshalable types. If present, the
+// context.Context parameter must come first. On invocation, the request body
+// is unmarshaled into a new TReq, fn is called via reflect.Call, and any
+// TResp result is marshaled back into the response body (a nil TResp is
+// marshaled as null). This avoids
+// having to hand-write the unmarshal/marshal boilerplate in every Handler.
+//
+// RouteFunc panics if fn does not match one of the shapes above. Any mws
+// passed are applied as with Route.
+func (r *Router) RouteFunc(procedure string, fn interface{}, mws ...Middleware) {
+	r.Route(procedure, newTypedHandler(procedure, fn), mws...)
+}
+
+func newTypedHandler(procedure string, fn interface{}) Handler {
+	fnVal := reflect.ValueOf(fn)
+	fnType := fnVal.Type()
+	if fnType.Kind() != reflect.Func {
+		panic(fmt.Sprintf("router: RouteFunc(%q, ...) called with non-func %s", procedure, fnType))
+	}
+
+	numIn := fnType.NumIn()
+	numOut := fnType.NumOut()
+	if numIn < 1 || numIn > 2 {
+		panic(fmt.Sprintf("router: RouteFunc(%q, ...) fn must accept 1 or 2 arguments, got %d", procedure, numIn))
+	}
+	if numOut < 1 || numOut > 2 {
+		panic(fmt.Sprintf("router: RouteFunc(%q, ...) fn must return 1 or 2 values, got %d", procedure, numOut))
+	}
+	if !fnType.Out(numOut - 1).Implements(errorType) {
+		panic(fmt.Sprintf("router: RouteFunc(%q, ...) fn's last return value must be error", procedure))
+	}
+	if numIn == 2 && !fnType.In(0).Implements(contextType) {
+		panic(fmt.Sprintf("router: RouteFunc(%q, ...) fn taking 2 arguments must take context.Context as the first", procedure))
+	}
+
+	takesCtx := fnType.In(0).Implements(contextType)
+	takesReq := numIn == 2 || (numIn == 1 && !takesCtx)
+	returnsResp := numOut == 2
+
+	var reqType reflect.Type
+	if takesReq {
+		reqType = fnType.In(numIn - 1)
+	}
+
+	return HandlerFunc(func(ctx context.Context, body json.RawMessage) (json.RawMessage, error) {
+		args := make([]reflect.Value, 0, numIn)
+		if takesCtx {
+			args = append(args, reflect.ValueOf(ctx))
+		}
+		if takesReq {
+			reqPtr := reflect.New(reqType)
+			if err := json.Unmarshal(body, reqPtr.Interface()); err != nil {
+				return nil, fmt.Errorf("router: unmarshaling request for procedure %q: %w", procedure, err)
+			}
+			args = append(args, reqPtr.Elem())
+		}
+
+		out := fnVal.Call(args)
+		if err, _ := out[numOut-1].Interface().(error); err != nil {
+			return nil, err
+		}
+		if !returnsResp {
+			return nil, nil
+		}
+
+		resp := out[0].Interface()
+		// Mirrors aws-lambda-go's rule that TOut may implement io.Reader to
+		// stream its response: if resp is a reader and doesn't also opt into
+		// its own JSON encoding, its bytes are embedded directly rather than
+		// being marshaled. The caller is responsible for those bytes forming
+		// a valid JSON value, since they're placed straight into
+		// Response.Body; procedures needing true unbuffered streaming should
+		// use RouteStream and HandleStream instead.
+		if reader, ok := resp.(io.Reader); ok {
+			if _, isMarshaler := resp.(json.Marshaler); !isMarshaler {
+				return io.ReadAll(reader)
+			}
+		}
+		return json.Marshal(resp)
+	})
+}
@@ -0,0 +1,113 @@
+package router
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type greetRequest struct {
+	Name string `json:"name"`
+}
+
+type greetResponse struct {
+	Greeting string `json:"greeting"`
+}
+
+func TestRouteFuncWithRequestAndResponse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.RouteFunc("Greet", func(_ context.Context, req greetRequest) (greetResponse, error) {
+		return greetResponse{Greeting: "hello " + req.Name}, nil
+	})
+
+	result, err := router.Handle(ctx, Request{
+		Procedure: "Greet",
+		Body:      []byte(`{"name":"world"}`),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"greeting":"hello world"}`, string(result.Body))
+}
+
+func TestRouteFuncWithNoRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.RouteFunc("Ping", func(context.Context) (greetResponse, error) {
+		return greetResponse{Greeting: "pong"}, nil
+	})
+
+	result, err := router.Handle(ctx, Request{Procedure: "Ping"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"greeting":"pong"}`, string(result.Body))
+}
+
+func TestRouteFuncWithNoResponse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	called := false
+	router.RouteFunc("Ack", func(_ context.Context, req greetRequest) error {
+		called = req.Name == "world"
+		return nil
+	})
+
+	result, err := router.Handle(ctx, Request{
+		Procedure: "Ack",
+		Body:      []byte(`{"name":"world"}`),
+	})
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, &Response{}, result)
+}
+
+func TestRouteFuncWithHandlerError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.RouteFunc("Fail", func(context.Context) error {
+		return assert.AnError
+	})
+
+	result, err := router.Handle(ctx, Request{Procedure: "Fail"})
+	require.NoError(t, err)
+	assert.Equal(t, &Response{Error: []byte(assert.AnError.Error())}, result)
+}
+
+func TestRouteFuncPanicsOnInvalidShape(t *testing.T) {
+	t.Parallel()
+	router := New()
+	assert.Panics(t, func() {
+		router.RouteFunc("Bad", func() {})
+	})
+}
+
+func TestRouteFuncPanicsOnTwoArgsWithoutLeadingContext(t *testing.T) {
+	t.Parallel()
+	router := New()
+	assert.Panics(t, func() {
+		router.RouteFunc("Bad", func(req greetRequest, other greetRequest) error {
+			return nil
+		})
+	})
+}
+
+func TestRouteFuncReturnsErrorOnUnmarshalFailure(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	router := New()
+	router.RouteFunc("Greet", func(_ context.Context, req greetRequest) (greetResponse, error) {
+		return greetResponse{}, nil
+	})
+
+	result, err := router.Handle(ctx, Request{
+		Procedure: "Greet",
+		Body:      []byte(`not json`),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Error)
+	assert.Contains(t, string(result.Error), `unmarshaling request for procedure "Greet"`)
+}